@@ -0,0 +1,66 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file buildpack.go
+ * @package ci
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package ci
+
+import "fmt"
+
+// BuildpackProvider scaffolds a Paketo-style project.toml, for teams
+// building images with pack/buildpacks instead of a Dockerfile-driven
+// CI step.
+type BuildpackProvider struct{}
+
+func init() {
+	register(BuildpackProvider{})
+}
+
+func (BuildpackProvider) Name() string {
+	return "buildpack"
+}
+
+func (BuildpackProvider) Files(ctx ProjectContext) (map[string][]byte, error) {
+	content := fmt.Sprintf(`[project]
+id = "%s/%s"
+name = "%s"
+version = "0.0.1"
+
+[[io.buildpacks.build.env]]
+name = "BP_GO_TARGETS"
+value = "./cmd/%s"
+
+[io.buildpacks]
+builder = "paketobuildpacks/builder-jammy-base"
+image = "%s"
+`, ctx.Org, ctx.Name, ctx.Name, ctx.Name, ctx.DockerTag)
+
+	return map[string][]byte{
+		"project.toml": []byte(content),
+	}, nil
+}
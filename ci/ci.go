@@ -0,0 +1,100 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file ci.go
+ * @package ci
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+// Package ci generates the CI configuration of a scaffolded project.
+// hwt new used to hard-code a single DroneCI pipeline; a Provider now
+// owns one ecosystem's config so "hwt new --ci drone,github" can mix
+// and match whatever the team in front of it actually uses.
+package ci
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ProjectContext is the subset of a project's identity a Provider needs
+// to fill in its templates.
+type ProjectContext struct {
+	Org       string
+	Name      string
+	Author    string
+	DockerTag string
+}
+
+// Provider generates one CI ecosystem's configuration files.
+type Provider interface {
+	// Name identifies the provider on the command line, e.g. "drone".
+	Name() string
+	// Files returns the provider's output files keyed by path, relative
+	// to the project root.
+	Files(ctx ProjectContext) (map[string][]byte, error)
+}
+
+var providers = map[string]Provider{}
+
+func register(p Provider) {
+	providers[p.Name()] = p
+}
+
+// Get looks up a registered provider by name.
+func Get(name string) (Provider, bool) {
+	p, ok := providers[name]
+
+	return p, ok
+}
+
+// Names returns every registered provider's name, sorted, for use in
+// --ci's help text and the interactive multi-select prompt.
+func Names() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Resolve looks up every name, failing on the first one that isn't a
+// registered provider.
+func Resolve(names []string) ([]Provider, error) {
+	result := make([]Provider, 0, len(names))
+	for _, name := range names {
+		p, ok := Get(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown CI provider %q", name)
+		}
+
+		result = append(result, p)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file drone.go
+ * @package ci
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package ci
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+const droneTpl = `a2luZDogcGlwZWxpbmUKdHlwZTogZG9ja2VyCm5hbWU6ICMjI19fUFJPSl9PUkdfXyMjIzo6IyMj
+X19QUk9KX05BTUUjX18jIyMKCnN0ZXBzOgogIC0gbmFtZTogYnVpbGQKICAgIGltYWdlOiBnb2xh
+bmc6YWxwaW5lCiAgICBjb21tYW5kczoKICAgICAgLSAiYXBrIGFkZCBtYWtlIGdpdCIKICAgICAg
+LSAibWFrZSBzd2FnIgogICAgICAtICJtYWtlIgoKICAtIG5hbWU6IGRvY2tlcgogICAgaW1hZ2U6
+IHBsdWdpbnMvZG9ja2VyOmxhdGVzdAogICAgc2V0dGluZ3M6CgogIC0gbmFtZTogZGVwbG95CiAg
+ICBpbWFnZTogYXBwbGVib3kvZHJvbmUtc3NoOmxhdGVzdAogICAgc2V0dGluZ3M6Cgp0cmlnZ2Vy
+OgogIGV2ZW50OgogICAgLSB0YWcK`
+
+// DroneProvider scaffolds the .drone.yml pipeline hwt has always shipped.
+type DroneProvider struct{}
+
+func init() {
+	register(DroneProvider{})
+}
+
+func (DroneProvider) Name() string {
+	return "drone"
+}
+
+func (DroneProvider) Files(ctx ProjectContext) (map[string][]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(droneTpl)
+	if err != nil {
+		return nil, err
+	}
+
+	content := string(raw)
+	content = strings.ReplaceAll(content, "###__PROJ_ORG__###", ctx.Org)
+	content = strings.ReplaceAll(content, "###__PROJ_NAME__###", ctx.Name)
+
+	return map[string][]byte{
+		".drone.yml": []byte(content),
+	}, nil
+}
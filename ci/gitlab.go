@@ -0,0 +1,73 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file gitlab.go
+ * @package ci
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package ci
+
+import "fmt"
+
+// GitLabProvider scaffolds a .gitlab-ci.yml pipeline.
+type GitLabProvider struct{}
+
+func init() {
+	register(GitLabProvider{})
+}
+
+func (GitLabProvider) Name() string {
+	return "gitlab"
+}
+
+func (GitLabProvider) Files(ctx ProjectContext) (map[string][]byte, error) {
+	content := fmt.Sprintf(`stages:
+  - build
+  - docker
+
+build:
+  stage: build
+  image: golang:alpine
+  script:
+    - apk add make git
+    - make swag
+    - make
+
+docker:
+  stage: docker
+  image: docker:latest
+  services:
+    - docker:dind
+  script:
+    - docker build -t %s .
+  only:
+    - tags
+`, ctx.DockerTag)
+
+	return map[string][]byte{
+		".gitlab-ci.yml": []byte(content),
+	}, nil
+}
@@ -31,137 +31,27 @@
 package cmd
 
 import (
-	"archive/tar"
 	"bytes"
-	"compress/bzip2"
-	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"text/template"
 	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	"github.com/fatih/color"
+	"github.com/herewetech/hwt/ci"
+	"github.com/herewetech/hwt/projectinfo"
+	"github.com/herewetech/hwt/templates"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
-)
-
-const (
-	tplArchive = `QlpoOTFBWSZTWTwPUP4ACsL/0//9YBB///////////////5AUARAKAAAkAAIYBuc6A6Pt9nX3N6U
-NZ2x4307e76N2XeXn3wO27Fdze97wK2WqrzgU4Ul9GXRvbcawrdd1tdnJNan27mQeCqGwGobCSQU
-0ynpkmmaKZ6k2aVNBtTxiobFNBtT1A8oeKHinqDI0AAaGamgxRNGBARPUaKeanqnkTQ0D1A9QAaZ
-HqMAgAA0ABiANAJqQoGQ9TT0PVDCaDTAhkNABiAaABppoaaZDRoAJNRIQiYFNPQZARhpE8kfqIDQ
-fqmmmgAADah6noh6nqND1D1AikIE0DQTAiaE2TBUNPCCHpGTIZNGjIBpoAAANAJEggEDUyT0NIyY
-TITyqe9SjJ6h7VNPUPUyPSeo9Q0P1IDCM9UxDIabP/eQIfuKQO3VQopPWO/BhLzBMwgypFEkjoSB
-A5e7py1Zb4Bwa+XBV8ZQEKkmZDkTIk3MSpsaoqo9BKb6HqEolHqOhpDqbiDBDVxG1QokKGUxKKCm
-EEkECAgWERjIMGSyBthJGKkkEQoZAKEWKsgsVRioMFFFFZBRVRFVEgoxWSQRgCxjIjAUgLEZCM3Y
-eR/Gh2vir2D+wxRPAVQrD9Fftqp40rFZF3aIG2nwoXfLQeGiqWrEUFFGqxgtuoxxMFnuCcztbPjv
-D58eZoAYLCWdOVvTbPXubo4GvaqmS9jsSM+111OzSK6wZKSFWPyfBv4wOvWFSojTP5dzrOJhQYCy
-crM0Rs6tF3XI3YyHG5MMBjVUpB4vPYZK8HWomtRpO0zZu7beUeGRPkBGHlEpz7W9Z5XIOBkLw7Oy
-Q2BodJJFudbKr4ZWhHqJqTD7sRyZRram3gBiFtFpTHsDLiRK9F5UGCKwjGIMT0SRAkYKSU3KQpqF
-JKdIpcpJTUpWp28Mz0NBv/HNIW+3TGrklM2G4sPrxghqY3u6nqMHSW/CUNGc+Qe/LLfrNty5xiDA
-aQi5hsjleQ9UTk9sYGxOxxX18Dtw8KhBJCGsIJCQ4/MvGBHHlWW5mj8czi84Mim56qDZ+VkgqNGp
-+q+LIILq4mDqvCc2VONDaVIGwIzfGfzhuH4QFKyZiQCt2KjXnvU4qel0UB69/Hlv6biI3TxvVz6s
-CLq0f5hClx1H9oekRQ6SBTq7a3UdUr3uuN0Jlzm5TNLfXDt4c0WXd1ey2mmZ1ynOHbquJS/POuXc
-X/s7aexJc2vIgRq3ySJVkfLcIYXV28LgOhoCLeQPgv1siv24miqEK+dTmslLi8JTqunXXqcNuf6P
-z3y9fkkuCQxpDYkM7TtOz7yR945Jdg54YS8MEiXpmUOrEqkbIuqXg7PL/KPHR5tidEiwNZMkXc1X
-h2Yvo6oW0XrtiSIyiSNhIyfVFjdhdubvSMpW67PDLYV0bMaCbPDj71s3RphscMjTY3UuIPwbtulI
-8UjqsLVv246y6u5I3EMlaYkz3NWX+lIvjc/XNIhXul0RGc8Vtsiz8MaBrmS/wkQxaZ10oW+MKlBS
-Xdo9/7iRea80jfcVsuyyDOuSoTgg0Qc1lcWtCV/dzZK+Nu1S4xmUkNeFJQ0jCKL3hcSmy8onpqSZ
-vJrAckDMOzPFIqjjUtttpWvJKstABZRPeKBP2u5Y4kcyUgnVWtgBtkjPeWPcmbMahHVOLXbd2LND
-QSHOwnKrgJC5KoZkjajs5jOAEi/PA20Ba9IfPSkUiRFESKZNmyJ96V49TTV2xpHjpE15pjFNlPlM
-T3dEHMIrg/m2xEojd5+GNlhqJgHdcWl6ArNaxZ9MpPm9rOPlnXCVwAhw9Btng2X4Y7L0qKmrRuU5
-Qh0TvYUHnRZoouf5bXZa1niNbRGfm5eQ6JgrLhryyKCKQpnfZnTcrrXbeYAoooIJAi3JMcIStdq3
-UwNttpuJs2vpd44wWO8lCsQmEChlXYS3nuiw56LyjWMkKpLOwEn/GEdKkkOSATVJDvrpR3uqipi5
-DMJCY8eA5szMpbOfymIqkZTCkQROkSG05q2gzthaf2obPwFYOiyElv4eQOu/vgtwX5MXSKiQgau3
-ZAfqyHKzRELqYqWbSq7JERo9tyq+t+uulF7S0pD+W+khd7AtYKwCwYfa04nfO+7VQworlmY1c5pl
-qqaLSWDnQ4NyuH3Z21MxDFHElz5my1xsFwfyifH7vSDth40Bv6+BNSOEWj1Ft034Qot8CsXUkJLc
-i0DCXEVXtsijJd2MG3ClzdCsXrQ2hM0rC/am1zYo92zS9NlcnORIlP31CvFek73ZuzTHIExXps+o
-vv5XDWmRDnvygslZoImWWjdNcjU8nNG7kkTKuBZGardXKkn7Nt1ff0YHGm3ZXGzRn7lPKKWx2KoD
-R+6wUAChgZtV1Bt3c7PdKPClZUKT7fmhlIX8cfFK2UEoIXecDA9HPzVVg0SODqMmDPpt7p8dM2XT
-SOPStT2pBuzj+bYkOPu5HXIwdHVIY4gbxZzKhIbQzURLk2sokeCRD0dbjx2dXDOcXl8kDy8uX++u
-OTWT+/6sYpZplX6L1TA4dmIiFOAo6IUhk51KgdHCBEG8amnMbPZ09ZQptKzB92iHYrL1++XRZfKx
-qY8pEr7/Zo+fRW62wUIsMCJzuktJ9onW2lgMhUFYdaO4ICcRt0dGkXSNYcIrh2Vg8WOXJIAw524Y
-Kw0gQqNUSb5Fcs2JQPfg686rEGbpKsruOdTcppgMaJDZ5INAImeuAxFDrVHFscS1bSyCEKWXwDbX
-1PBz3PAID8kNCEOTTdVvlkq4DAVYC2Flb89Tw4vfS2ga8d8vbnw0ZzFYmK4Mbzw4fJrObBX3Nfwg
-J4icKI0HcoyfbdyxuPBIk55RmeCQ/oG9rq4/qd7u0+I3Ftn+cfjJf+4ARcYBFVPi00CIfIJDgg9M
-wHWyTmbPAEK9T/J6QgXYsPjraA0YDsylyS0HjxdViRzAE5utbJQVwGcvD3/cstrY1J2u6FBA8mSk
-vySCxlXKnvnEwyZcCHW6kMrK6iBEaARpKyuuMp/EF8EKL+ki2BGFcWT+EFjPg6SZE2atFsXXkiVH
-fFJaCQXP7Fx+ElcCCLHi3SBYQEIOI9c0IMsb4sSTJXvISLUiE0ASCjHWL2mVlIKpJpQNiTYMLaxb
-TCYZVtRZsvRZbSZhiAS+iBeKRKR91ieOUXWn0bUyUoWEg0yum8qKqr1rlRlxh6JDxSGeNdKrzeGb
-8k154vCzimLYVoRS/Q6ElQrO2cpAIuRE26TrO1oLbJ0aNov6evTuZ8+86G783cSMKR3B0K2fKw7p
-cOZmZTGm5MbJDEhp+zZmed9bq38Xrnj+Hd5+QkPdvNYqqqqqqoqx6fQ3+D6u9JJn73aCHckMH2H+
-s6NcrXyVuCYzrPPOis8Hs0UqRUSIr6LZKlsWTJuGrD1C9sOA8L+9hPrP4gaokcwkUFWnGfsXKMrQ
-kUP48F8210OnCogwIgIT2bu5BAGQU0P6az4Pgio/DlIiIqQzjR/SmAXtQHyv8lTcpcdx/EHcsEiF
-dBhL2/9UlRx6+XjcRDcBPEDDz8Zibmm5GGqWJHwi8Ogvj9hbrUj6Y+o6j+k5QegjlGDaGMrVpA39
-45DbDYe1ub7sqmBzJwN441nuHwbuB5E9xRsIkhWSOJhOkdbeYN1YjSSK33yF86oV26d50OzhAtzx
-tq0xbtP35vC9OZjHZZVTekksHFlJ02+fVNuzrP0OYJprIJG9OkhOBu1Behuecw3bt0yyFPZ37Y4z
-96OrgUZN+Gj0pdC2QS0CZUNeWvXXDE/+4hs9DPipc80ohER0huK8qKmkegvIqghiI+P4dPikiZjc
-vN7Speuk0nGurwAn2HUg1HScazNa5nt2ZDk22233k5nTSRz87fQeu6qqbwoJB5lSBca5tKPBh3Y5
-H1VW85jv7oOpcx1mu98SzZm2CYRFCJNEhtPPNbOEupBwIPPzr14XsxjxyXCZ2wvQVQUZZhmMIjxm
-1DnAwGMKstrySXWX44M5WqTkRLwga2jtIQMFC4AIv8/TEds+zmNwZkrg6tyPjmki6ayKgvrI+4Th
-sE5g4S8jAsTJjfjWa4b92g2m79QoatXaS6DnMOZglmwpX1t1Udx5CoGzhp7zDBOCXaPYGxHrsdIb
-BqZgJ2QWn47QJJWqY9Y4FZd9+KR0bIOBXzEduXATpVMlQN2jAbKzBjyeItSSaa8Pb8emniOj17Cg
-Sc2lB9sIXXLrdJqsQp/NxlJg4dh0ZhtG40H6gRPNSRgHpFGQUTOLOaRMXCnYMHszx4w4pzdNpPzT
-ZidizCteOaSM+TJIdI0EAzZrdnFSRiobAt63KzMkYSRrNXGek13M5GA1EjPPfTZuYEEG4TGAz79O
-7YH2ztiP0D+dnf03nmcJMDrAGjjJ+hubctpgCyaWGygFkSRWYzHqpmYhWPcSZkgZlntnWhiQ0+hY
-TKQu25BlKI/egE4SexOHwKvBMEuxEbm9IHEZ9nh+0yHvJScgJf8G9STkYVL8jKimkKQeqHoPxP1b
-PEKQZA+R8pQLm4mR7FZ8fZI9CZ3pFIWSmY75vHvoaAKLNYCB9fRrUjieSDkUntgpSpnUco7KBhVH
-lisMjgHDaHZ5MiQLCb29qxE4Ug0YB65C9EzXUVqe6hyTCqYUPWIgJi3gnIviPqG3i8VqUsU9WqVr
-5fYmTUh84vsmoSSmNtgqNNUUWKHZC/UmQUmwrh4ZUrpnTURgsMgeVPGOzZERHoUrRq3jZj22Z7o/
-PHRB8SkxDxFc+eBIPApKgNRCB1F0lOwqVtMRr/1ZeMN30RB/wAcXT6jKSMEmARohTlSJsU7UiQJm
-SaZMXFULKyhAaj3N60uiuCRQiYpd4KFjkyd/CkzYg1wRLICIEiEDlqYfy5qwvt4QQx19wgiEKjPa
-jz6cJ0IhI6wlPltBeYHgN4rUjWtHCGWwiYcilJiwFkAMEL4XFJ1lFISKll0SVHQaskRztTpwUArT
-nUyBkU9ur5NRxDTzjRyp5hiGKiEzWEMG0gqsg0HpYGByyuVnbfdPdksTIdpOatp87RpUojmcyl7x
-rlK6UNZWOJnPDljBTOEUw69m6fGaRCcuzM5qGaOSpTkOia3eewtvIwvfqIl7IKVKlBzxNETHVhBp
-YgkNQIwI1I7RQk4MnEHSJhyeAlQK4CVKEYlBA0y6MSxW0GSspRPpKgVvSLK1MVAtDSbkZN1o5TwW
-EUZNwOKk5LFN0EuKiVRpWsqiUm8ZnqPC1FGvYUT6AN3mJK+p/AQBItyY1+sznGVTBSYmSikkiJEo
-tzqV+9jqUvIIjZNDQQt65lgDg3VxkSUnZDdVz02nfUg9bdiLS6B64SL4S4S1HUGstWlCuNQzAhSi
-CCeuc6ao4uOslyNDQy0mZ160ojLHOi7zLyI4lYtVZIowHJqBXZUuTCrQby6aq0axLNoYw5bohgjp
-44VUGWK1HMDSGDYDGzSr9dnXbEChIzUtlTkNyRQAbYNWgvJb2naJp9BS54QSQkZVMwFCZKJHhU03
-M8zaC5vcX00ODAoqCN0VMGPUDb1wyrHUtNASAFgOYBgXckSMtErNS50Ht670TvokMLEj6AxCvj1N
-Fn7blYBvWRlFDsaoAhTHcG0tKdEGbMJd0lo6s8RuA9+Q0AmOzq7lmSB20vBQTKqwgxoURZMqgjMu
-pulGgGRpnYzShrBUmDpQzliEnD2AArb2E+sPAYEUFjGDGR2YCRZ42rNCbDxo0ic4QplMAKPJZq8u
-Y9GTKm9t9SnpStV+rZYCBlQI0wBSCxkL5kUhJSIIGpot6UwUy2g1oQo18u6KhTAtvk2CE6k9819q
-mhStTgwEnYC9ykzKpdKwrUmTIDpbzUXKeRSuxS9XWrywECVilFO1SuaZdwG+EIiISHlCgPqwkbgH
-V0JOAkGQ7qBEGK7ZDKTdTwjSSlIdamk56Yp8NGywxzTWe6pqpRqj1oAsiGC7i0b0P1dkIh/ZfosE
-HOXesyyaBtA94L094y1alaqAvpFCRDQaBFImGpzAy7GfK5f0OQqAMaRb9iogoD25JHaaTG8OtNQf
-IDgE3ie2p1HZT7Mgdr5fX7Ghpoiii7PDDrMKlKCyE9XvRoUUothec3qUZK4Wo5wILssqKTlIIpJK
-kKgejapMGA5jC1VRZlReMGo6Js7assL/r8vXaGJzaXIFvYk279CkgKZmbb5kKwFEnNrbEySG3APL
-Zs2xWP0FORlEQyJXB5+y3VnD0DLh0Zsy0PQBlrSYNKIXyMGD3JH18/v++KKHFYkR8DEnI4gVyEgg
-nEiNaQ/wd8AgU8aKgqYDpYV5lO2aaTNyZJvrbfBp0qREBDCkIkIY1KprbOTRyM0hi7mh1p4KRW0s
-Ew0Elr5fGt8KaRHUzfWSkFlRiffGgNbe3T5ADt5wR1aUzsObhwWXEahC5RWq6JYVK1Q6NbphIdJ2
-4fLE2nxIzrAfSxqY8NciEy1lFIgmpGoSqvOQSVoLKCNQTMWGIULK4IATS6GMoTMzgHRBLzIKKnQ0
-h1faznuhD0hDSTMAZsiMY1xpc2CtRIGJDfACECC00PFFyVCR6oE4Ldxv7UACsmGdvLpHxVyHZEEC
-voirRUOj7Z64saSUDI9QOlE45lMiGfGheRAQtilgHxmX2thd6fm9RuzCHBr9/gDvziDdSVBRjICB
-oEwsIiQ3t9kTIvUkhIgyFAPDkU6GoGtSe9TLLYZZIE4H2ksC7rLQC1fpwFViRlEq1S6nx9WWtqZj
-20jGYnwk9I1FJIxEpBWsQhqkACJnWhq52ziHEVi1UMqQxC8OWAKh7/iVWDBjN3c3S85Wgs7YMDAw
-3PGwFCnYttKUG3Ii7k4QJNRJTlwe641LRMfetMD0QsIZ6eCJKawD2i1Tz2i8hWwOlh1uRsduk4JE
-EM7VTfrDhqCc2neaJGbgQqclgYkLd2pVyVFyIl1V3QowjBTKqoaTAlJFwGC5WmCShIATgIOJIbw6
-219G77GY9sLThektckuZC78YYN/Lq8Ya2DmC2CuvQ9CHhnDoKog8QkwXBzRgYLohTQWQpnU5VJIk
-hCbqA3haKWKYmWqEkC3pTUFymElNwrPBwI79qqeQhhgS9hIDESfZmjNwVLFLSNQxAa5jaoCpvYk2
-xPi9iaU0/ZqzfaJmUuSxy3Wz9A61rEEJFjxSJtIj00rU2LCcqun1KJXVCCUvnHdlwure6WjwoElE
-wkUlO9ND0NMokX80UEhmkm2XqRomlpTF3jNpxkwCoda3GtrTKC6GxjknIGNFnQBfl0l6RQ3s9CRq
-PoBWK8Q7EFlhlzrtsU61MjUxrfcD4oYIUL0vhSL+9jxoCMdy/XCJHLONwPXE5ScShSBG23C+VMbk
-rlIxhYr8MJaw9V05r0C6qTO2CTKQp3RknEoslCfqjjRIYcmozMzXlnZCBh5JFts60dg1G0pXx3BZ
-UsMCyT9IFLakMGxstoU9G0tsubsHFCUpREA5ECkdtl5EWqIditpekRaWF0xl5CtwIJDYMuqQSHR1
-ZALFGpIaQ/Et0kwxgTBpINDESZXeNcrblSd0AQmtxJoaFZGM6GgFELW4u78bddiJVex03MmUgk+B
-TLMA04lJKMxC1SArbSqdQtQPGAKQMQPiZ6bcHYSCBggz7b5qXVuS/HdXpXgrQfHa5wxEAmyIgVxf
-+24zipCBQUmzXQZRZgtQNnWl7uLo12m7w+xXdkENJnd5nWCADtPONCH5+hN6lAxPmriAxvicx3AP
-K5AA5Svk6H06Cy26omAkbg7vme8CYyhCQnmYGDvkwfNtE2MGNDQmmhaRZPMgJ0FlZz7yGdFgEw/3
-VIdS3qbx5By4pKYlsAljgiCKSWUpQxvttQoGJGO8IaH2X3oLmEHS1iJEkDBc7UL2btaRkfL4WIw8
-hB9OjAyzj7hEPzBD+yEJL4+7SUcxoW9IlmkSRDM6BDhI1YugfvNqqRRIp2gHcvHj8rOHCODZI6Vt
-AVDOh5bIRD0xBJ5mQnOeMooTPwJ6bNhA7gSOo8VakOkVSpOVCs6EQP/i7kinChIHgeofwA==`
-	dotDroneTpl = `a2luZDogcGlwZWxpbmUKdHlwZTogZG9ja2VyCm5hbWU6ICMjI19fUFJPSl9PUkdfXyMjIzo6IyMj
-X19QUk9KX05BTUUjX18jIyMKCnN0ZXBzOgogIC0gbmFtZTogYnVpbGQKICAgIGltYWdlOiBnb2xh
-bmc6YWxwaW5lCiAgICBjb21tYW5kczoKICAgICAgLSAiYXBrIGFkZCBtYWtlIGdpdCIKICAgICAg
-LSAibWFrZSBzd2FnIgogICAgICAtICJtYWtlIgoKICAtIG5hbWU6IGRvY2tlcgogICAgaW1hZ2U6
-IHBsdWdpbnMvZG9ja2VyOmxhdGVzdAogICAgc2V0dGluZ3M6CgogIC0gbmFtZTogZGVwbG95CiAg
-ICBpbWFnZTogYXBwbGVib3kvZHJvbmUtc3NoOmxhdGVzdAogICAgc2V0dGluZ3M6Cgp0cmlnZ2Vy
-OgogIGV2ZW50OgogICAgLSB0YWcK`
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -176,15 +66,177 @@ var (
 	projAuthor    = ""
 	projDockerTag = ""
 	projPath      = ""
-	projDrone     = false
+	projCI        []string
+	projTemplate  = ""
+	projConfig    = ""
+	dryRun        = false
+	force         = false
+	projVars      = map[string]interface{}{}
 )
 
+// ProjectConfig mirrors the prompts in generateRun, for users who'd
+// rather hand hwt a YAML file than answer them one by one.
+type ProjectConfig struct {
+	Name      string `yaml:"name"`
+	Org       string `yaml:"org"`
+	Author    string `yaml:"author"`
+	DockerTag string `yaml:"dockerTag"`
+	Path      string `yaml:"path"`
+	// CI lists the CI providers to scaffold, e.g. ["drone", "github"].
+	CI []string `yaml:"ci"`
+	// Vars supplies values for the variables a template's manifest.yaml
+	// declares, keyed by variable name. Values keep whatever type the
+	// YAML parses them as (string, bool, number, ...), so a manifest
+	// variable with a boolean-flavored default can be overridden with
+	// an actual bool instead of a string that's always truthy.
+	Vars map[string]interface{} `yaml:"vars"`
+}
+
+func init() {
+	generateCmd.Flags().StringVarP(&projTemplate, "template", "t", "", "Template name (from registry) or git/https URL, defaults to the built-in starter")
+	generateCmd.Flags().StringVar(&projName, "name", "", "Project name")
+	generateCmd.Flags().StringVar(&projOrg, "org", "", "Project organization")
+	generateCmd.Flags().StringVar(&projAuthor, "author", "", "Project author")
+	generateCmd.Flags().StringVar(&projDockerTag, "docker-tag", "", "Docker image tag")
+	generateCmd.Flags().StringVar(&projPath, "path", "", "Project path")
+	generateCmd.Flags().StringSliceVar(&projCI, "ci", nil, "CI providers to scaffold, comma-separated (drone, github, gitlab, buildpack)")
+	generateCmd.Flags().StringVarP(&projConfig, "config", "c", "", "Load project settings from a YAML file instead of prompting")
+	generateCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Render the template tree in memory and print the file list instead of writing to disk")
+	generateCmd.Flags().BoolVar(&force, "force", false, "Allow generation into an existing non-empty directory")
+}
+
+// loadProjectConfig reads a ProjectConfig from path and copies over any
+// field still left at its zero value, so explicit flags keep precedence.
+func loadProjectConfig(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var cfg ProjectConfig
+	if err := yaml.Unmarshal(raw, &cfg); err != nil {
+		return err
+	}
+
+	if projName == "" {
+		projName = cfg.Name
+	}
+
+	if projOrg == "" {
+		projOrg = cfg.Org
+	}
+
+	if projAuthor == "" {
+		projAuthor = cfg.Author
+	}
+
+	if projDockerTag == "" {
+		projDockerTag = cfg.DockerTag
+	}
+
+	if projPath == "" {
+		projPath = cfg.Path
+	}
+
+	if len(projCI) == 0 {
+		projCI = cfg.CI
+	}
+
+	for name, val := range cfg.Vars {
+		if _, ok := projVars[name]; !ok {
+			projVars[name] = val
+		}
+	}
+
+	return nil
+}
+
+// projComplete reports whether every required field has been supplied
+// already, via flags or a --config file, so the promptui flow can be
+// skipped entirely.
+func projComplete() bool {
+	return projName != "" && projOrg != "" && projAuthor != "" && projDockerTag != "" && projPath != ""
+}
+
+// firstNonEmpty returns the first non-empty string in values, or "" if
+// they're all empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
 func generateRun(cmd *cobra.Command, args []string) {
 	fmt.Println("Generate new project")
 	if len(args) > 0 {
 		projName = args[0]
 	}
 
+	if projConfig != "" {
+		if err := loadProjectConfig(projConfig); err != nil {
+			fmt.Println(color.HiRedString(err.Error()))
+			os.Exit(1)
+		}
+	}
+
+	if projComplete() {
+		fmt.Println(" => ", "Project name", " : ", color.GreenString(projName))
+		fmt.Println(" => ", "Project organization", " : ", color.GreenString(projOrg))
+		fmt.Println(" => ", "Project author", " : ", color.GreenString(projAuthor))
+		fmt.Println(" => ", "Docker image tag", " : ", color.GreenString(projDockerTag))
+		fmt.Println(" => ", "CI providers", " : ", color.GreenString(strings.Join(projCI, ", ")))
+		fmt.Println(" => ", "Project path", " : ", color.HiYellowString(projPath))
+	} else {
+		generatePrompt()
+	}
+
+	if dryRun {
+		err := dryRunGenerate()
+		if err != nil {
+			fmt.Println(color.HiRedString(err.Error()))
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	err := makeProjDir()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	err = unpackTpl()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	err = writeCIFiles()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	fmt.Println(color.HiGreenString("Project created. Run 'go mod tidy' in your project directory and enjoy it."))
+}
+
+// generatePrompt runs the interactive promptui flow, used whenever the
+// caller hasn't already supplied every required value via flags or
+// --config. Author and organization defaults fall back to whatever
+// projectinfo can detect from the local git identity and working
+// directory, so users don't have to retype values git already knows.
+func generatePrompt() {
+	detectedAuthor := projectinfo.DetectAuthor()
+	detectedOrg := ""
+	if info, ok := projectinfo.DetectRemote("."); ok {
+		detectedOrg = info.Org
+	}
+
 	promptProjName := promptui.Prompt{
 		Label:   "Project name",
 		Default: projName,
@@ -201,7 +253,7 @@ func generateRun(cmd *cobra.Command, args []string) {
 
 	promptProjOrg := promptui.Prompt{
 		Label:   "Project organization",
-		Default: projOrg,
+		Default: firstNonEmpty(projOrg, detectedOrg),
 		Validate: func(input string) error {
 			if input == "" {
 				return errors.New("empty project organization")
@@ -215,7 +267,7 @@ func generateRun(cmd *cobra.Command, args []string) {
 
 	promptProjAuth := promptui.Prompt{
 		Label:   "Project author",
-		Default: projAuthor,
+		Default: firstNonEmpty(projAuthor, detectedAuthor),
 		Validate: func(input string) error {
 			if input == "" {
 				return errors.New("empty project author")
@@ -254,11 +306,6 @@ func generateRun(cmd *cobra.Command, args []string) {
 		},
 	}
 
-	promptProjDrone := promptui.Prompt{
-		Label:     "Enable DroneCI",
-		IsConfirm: true,
-	}
-
 	resultProjName, err := promptProjName.Run()
 	if err != nil {
 		fmt.Println(err)
@@ -292,17 +339,8 @@ func generateRun(cmd *cobra.Command, args []string) {
 
 	fmt.Println(" => ", promptProjDockerTag.Label, " : ", color.GreenString(resultProjDockerTag))
 
-	resultProjDrone, err := promptProjDrone.Run()
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
-
-	if resultProjDrone == "y" || resultProjDrone == "Y" {
-		projDrone = true
-	}
-
-	fmt.Println(" => ", promptProjDrone.Label, " : ", projDrone)
+	projCI = promptCIProviders(projCI)
+	fmt.Println(" => ", "CI providers", " : ", color.GreenString(strings.Join(projCI, ", ")))
 	promptProjPath.Default = "./" + projName
 
 	resultProjPath, err := promptProjPath.Run()
@@ -312,34 +350,72 @@ func generateRun(cmd *cobra.Command, args []string) {
 	}
 
 	fmt.Println(" => ", promptProjPath.Label, " : ", color.HiYellowString(resultProjPath))
+}
 
-	err = makeProjDir()
-	if err != nil {
-		fmt.Println(color.HiRedString(err.Error()))
-		os.Exit(1)
+// promptCIProviders runs a toggle-style multi-select over the
+// registered CI providers: picking an entry flips its selection, and
+// picking "Done" returns every provider currently checked. promptui
+// has no native multi-select widget, so this drives repeated
+// promptui.Select prompts instead.
+func promptCIProviders(preselected []string) []string {
+	selected := map[string]bool{}
+	for _, name := range preselected {
+		selected[name] = true
 	}
 
-	err = unpackTpl()
-	if err != nil {
-		fmt.Println(color.HiRedString(err.Error()))
-		os.Exit(1)
-	}
+	names := ci.Names()
+	for {
+		items := make([]string, 0, len(names)+1)
+		for _, name := range names {
+			mark := " "
+			if selected[name] {
+				mark = "x"
+			}
 
-	if projDrone {
-		err = dotDrone()
+			items = append(items, fmt.Sprintf("[%s] %s", mark, name))
+		}
+
+		items = append(items, "Done")
+
+		prompt := promptui.Select{
+			Label: "CI providers (pick one to toggle it, \"Done\" to finish)",
+			Items: items,
+		}
+
+		idx, _, err := prompt.Run()
 		if err != nil {
-			fmt.Println(color.HiRedString(err.Error()))
+			fmt.Println(err)
 			os.Exit(1)
 		}
+
+		if idx == len(names) {
+			break
+		}
+
+		selected[names[idx]] = !selected[names[idx]]
 	}
 
-	fmt.Println(color.HiGreenString("Project created. Run 'go mod tidy' in your project directory and enjoy it."))
+	result := make([]string, 0, len(selected))
+	for _, name := range names {
+		if selected[name] {
+			result = append(result, name)
+		}
+	}
+
+	return result
 }
 
 func makeProjDir() error {
 	fmt.Println(color.HiCyanString("Making working directory"))
 	var err error
 
+	if !force {
+		entries, err := os.ReadDir(projPath)
+		if err == nil && len(entries) > 0 {
+			return fmt.Errorf("%s already exists and is not empty, use --force to generate into it anyway", projPath)
+		}
+	}
+
 	err = os.MkdirAll(projPath, 0755)
 	if err != nil {
 		return err
@@ -367,82 +443,380 @@ func makeProjDir() error {
 	return nil
 }
 
-func unpackTpl() error {
-	fmt.Println(color.HiCyanString("Unpacking templates"))
-	raw, err := base64.StdEncoding.DecodeString(tplArchive)
+// resolveTemplate turns --template into a concrete fs.FS, consulting the
+// user's registry (~/.hwt/config.yaml) for bare names and falling back to
+// a one-off git/http source when ref looks like a URL.
+func resolveTemplate(ref string) (fs.FS, error) {
+	if ref == "" {
+		return templates.NewEmbeddedSource().Fetch("")
+	}
+
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return templates.NewHTTPSource("", ref).Fetch("")
+	}
+
+	if strings.HasSuffix(ref, ".git") || strings.Contains(ref, "@") {
+		return templates.NewGitSource("", ref).Fetch("")
+	}
+
+	path, err := templates.ConfigPath()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	r := bytes.NewReader(raw)
-	ur := bzip2.NewReader(r)
-	b, err := io.ReadAll(ur)
+	reg, err := templates.LoadRegistry(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	tr := tar.NewReader(bytes.NewBuffer(b))
-	for {
-		hdr, err := tr.Next()
-		if err == io.EOF {
-			break
+	sources, err := reg.Sources()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, src := range sources {
+		list, err := src.List()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range list {
+			if t.Name == ref {
+				return src.Fetch(t.Ref)
+			}
 		}
+	}
+
+	return nil, fmt.Errorf("unknown template %q, see 'hwt templates list'", ref)
+}
+
+// execTemplate renders a single text/template source (a file's content or
+// its name) against data, with the sprig helpers available.
+func execTemplate(name, src string, data map[string]interface{}) (string, error) {
+	tmpl, err := template.New(name).Funcs(sprig.TxtFuncMap()).Parse(src)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s: %w", name, err)
+	}
 
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("rendering %s: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
+
+// baseTemplateData seeds the variables every template can rely on,
+// regardless of whether it ships a manifest.yaml.
+func baseTemplateData() map[string]interface{} {
+	return map[string]interface{}{
+		"ProjName":      projName,
+		"ProjOrg":       projOrg,
+		"ProjAuthor":    projAuthor,
+		"ProjDockerTag": projDockerTag,
+		"Today":         time.Now().Format("01/02/2006"),
+	}
+}
+
+// resolveManifestData extends base with the variables manifest declares,
+// pulling values from projVars, falling back to each variable's default,
+// and prompting interactively when neither is available and we're not
+// running fully non-interactively. Values keep whatever type they were
+// sourced as (projVars and Default may both be non-string, e.g. a bool),
+// so a template's "{{if .Foo}}" idiom sees the real value instead of a
+// string that's always truthy. Declared validation regexes are enforced
+// against the value's string form however it was sourced.
+func resolveManifestData(manifest *templates.Manifest, base map[string]interface{}) (map[string]interface{}, error) {
+	if manifest == nil {
+		return base, nil
+	}
+
+	for _, v := range manifest.Variables {
+		val, ok := projVars[v.Name]
+		if !ok && v.Default != nil {
+			val = v.Default
+			ok = true
+		}
+
+		if !ok && !projComplete() {
+			prompt := promptui.Prompt{
+				Label: v.Prompt,
+				Validate: func(input string) error {
+					if v.Validate == "" {
+						return nil
+					}
+
+					matched, err := regexp.MatchString(v.Validate, input)
+					if err != nil {
+						return err
+					}
+
+					if !matched {
+						return fmt.Errorf("%q does not match %s", input, v.Validate)
+					}
+
+					return nil
+				},
+			}
+
+			result, err := prompt.Run()
+			if err != nil {
+				return nil, err
+			}
+
+			val = result
+			ok = true
+		}
+
+		if ok && v.Validate != "" {
+			validateVal, isStr := val.(string)
+			if !isStr {
+				validateVal = fmt.Sprint(val)
+			}
+
+			if matched, err := regexp.MatchString(v.Validate, validateVal); err != nil {
+				return nil, err
+			} else if !matched {
+				return nil, fmt.Errorf("variable %q value %q does not match %s", v.Name, validateVal, v.Validate)
+			}
+		}
+
+		if ok {
+			base[v.Name] = val
+		}
+	}
+
+	return base, nil
+}
+
+// renderTemplate walks tplFS, runs every ".tpl" file (and its name)
+// through text/template with the sprig funcs, copies everything else
+// verbatim, and drops files that the manifest's conditional rules
+// exclude. It touches no disk state, which lets both unpackTpl and the
+// --dry-run path share the same rendering logic.
+func renderTemplate(tplFS fs.FS, manifest *templates.Manifest, data map[string]interface{}) (map[string]string, error) {
+	rendered := map[string]string{}
+	err := fs.WalkDir(tplFS, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 
-		fi := hdr.FileInfo()
-		if fi.IsDir() {
-			dirPath, _ := filepath.Abs(hdr.Name)
-			os.MkdirAll(dirPath, 0755)
-		} else {
-			cb, _ := io.ReadAll(tr)
-			content := string(cb)
-			today := time.Now().Format("01/02/2006")
-			// Write contents
-			filePath, _ := filepath.Abs(strings.TrimRight(hdr.Name, ".tpl"))
-			content = strings.ReplaceAll(content, "###__PROJ_NAME__###", projName)
-			content = strings.ReplaceAll(content, "###__PROJ_ORG__###", projOrg)
-			content = strings.ReplaceAll(content, "###__PROJ_AUTHOR__###", projAuthor)
-			content = strings.ReplaceAll(content, "###__TODAY__###", today)
-
-			err = os.WriteFile(filePath, []byte(content), 0644)
+		if d.IsDir() || path == templates.ManifestFile {
+			return nil
+		}
+
+		cb, err := fs.ReadFile(tplFS, path)
+		if err != nil {
+			return err
+		}
+
+		relPath := path
+		content := string(cb)
+		if strings.HasSuffix(path, ".tpl") {
+			stem := strings.TrimSuffix(path, ".tpl")
+			relPath, err = execTemplate(stem, stem, data)
+			if err != nil {
+				return err
+			}
+
+			content, err = execTemplate(path, content, data)
 			if err != nil {
 				return err
 			}
 		}
+
+		if rule, ok := manifest.RuleFor(relPath); ok {
+			result, err := execTemplate(relPath+":if", rule.If, data)
+			if err != nil {
+				return err
+			}
+
+			if strings.TrimSpace(result) != "true" {
+				return nil
+			}
+		}
+
+		rendered[relPath] = content
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest == nil {
+		rendered[projName+".json"] = "{}"
+	}
+
+	return rendered, nil
+}
+
+// sanitizeRelPath cleans relPath and rejects anything that isn't
+// confined to the project directory: an absolute path, or a path
+// whose cleaned form climbs out via "..". Template filenames are
+// themselves rendered through text/template (see renderTemplate), so
+// a malicious template could otherwise escape the project directory
+// and overwrite arbitrary files on the invoking user's machine.
+func sanitizeRelPath(relPath string) (string, error) {
+	clean := filepath.Clean(relPath)
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("refusing to write outside the project directory: %q", relPath)
+	}
+
+	return clean, nil
+}
+
+func unpackTpl() error {
+	fmt.Println(color.HiCyanString("Unpacking templates"))
+	tplFS, err := resolveTemplate(projTemplate)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := templates.LoadManifest(tplFS)
+	if err != nil {
+		return err
+	}
+
+	data, err := resolveManifestData(manifest, baseTemplateData())
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderTemplate(tplFS, manifest, data)
+	if err != nil {
+		return err
+	}
+
+	for relPath, content := range rendered {
+		relPath, err := sanitizeRelPath(relPath)
+		if err != nil {
+			return err
+		}
+
+		filePath, _ := filepath.Abs(relPath)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println(" => OK")
+
+	return nil
+}
+
+// writeCIFiles resolves the requested CI providers and writes their
+// files to the current directory (the project directory, by the time
+// generateRun calls this).
+func writeCIFiles() error {
+	if len(projCI) == 0 {
+		return nil
 	}
 
-	filePath := projName + ".json"
-	err = os.WriteFile(filePath, []byte("{}"), 0644)
+	fmt.Println(color.HiCyanString("Generating CI configuration"))
+	files, err := ciFiles()
 	if err != nil {
 		return err
 	}
 
+	for relPath, content := range files {
+		relPath, err := sanitizeRelPath(relPath)
+		if err != nil {
+			return err
+		}
+
+		filePath, _ := filepath.Abs(relPath)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filePath, content, 0644); err != nil {
+			return err
+		}
+	}
+
 	fmt.Println(" => OK")
 
 	return nil
 }
 
-func dotDrone() error {
-	fmt.Println(color.HiCyanString("Generating .drone.yml"))
-	raw, err := base64.StdEncoding.DecodeString(dotDroneTpl)
+// ciFiles resolves the requested CI providers against ctx and merges
+// their output files into a single path -> content map.
+func ciFiles() (map[string][]byte, error) {
+	providers, err := ci.Resolve(projCI)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := ci.ProjectContext{
+		Org:       projOrg,
+		Name:      projName,
+		Author:    projAuthor,
+		DockerTag: projDockerTag,
+	}
+
+	files := map[string][]byte{}
+	for _, p := range providers {
+		providerFiles, err := p.Files(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for path, content := range providerFiles {
+			files[path] = content
+		}
+	}
+
+	return files, nil
+}
+
+// dryRunGenerate renders the selected template in memory and prints the
+// file list that "hwt new" would otherwise write to projPath, without
+// touching the filesystem.
+func dryRunGenerate() error {
+	fmt.Println(color.HiCyanString("Dry run: rendering " + projPath))
+	tplFS, err := resolveTemplate(projTemplate)
 	if err != nil {
 		return err
 	}
 
-	filePath := ".drone.yml"
-	content := string(raw)
-	content = strings.ReplaceAll(content, "###__PROJ_ORG__###", projOrg)
-	content = strings.ReplaceAll(content, "###__PROJ_NAME__###", projName)
+	manifest, err := templates.LoadManifest(tplFS)
+	if err != nil {
+		return err
+	}
 
-	err = os.WriteFile(filePath, []byte(content), 0644)
+	data, err := resolveManifestData(manifest, baseTemplateData())
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(" => OK")
+	rendered, err := renderTemplate(tplFS, manifest, data)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(rendered)+1)
+	for relPath := range rendered {
+		paths = append(paths, relPath)
+	}
+
+	ciPaths, err := ciFiles()
+	if err != nil {
+		return err
+	}
+
+	for relPath := range ciPaths {
+		paths = append(paths, relPath)
+	}
+
+	sort.Strings(paths)
+	for _, p := range paths {
+		fmt.Println(" => ", filepath.Join(projPath, p))
+	}
 
 	return nil
 }
@@ -0,0 +1,123 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file generate_test.go
+ * @package cmd
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/herewetech/hwt/templates"
+)
+
+// TestRenderTemplateManifest exercises renderTemplate and
+// resolveManifestData together against testdata/manifest-example,
+// which declares a boolean variable, a conditional file and a
+// templated filename - the three manifest features generate.go
+// supports beyond the fixed ProjName/ProjOrg/ProjAuthor/Today set.
+func TestRenderTemplateManifest(t *testing.T) {
+	projVars = map[string]interface{}{}
+	projName = "widget"
+	projOrg = "acme"
+	projAuthor = "Jane Doe"
+	projDockerTag = "acme/widget"
+
+	tplFS := os.DirFS("testdata/manifest-example")
+
+	manifest, err := templates.LoadManifest(tplFS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := resolveManifestData(manifest, baseTemplateData())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rendered, err := renderTemplate(tplFS, manifest, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "# widget\n\nMaintained by Jane Doe (acme).\n"
+	if got := rendered["README.md"]; got != want {
+		t.Errorf("README.md = %q, want %q", got, want)
+	}
+
+	if _, ok := rendered["Dockerfile"]; !ok {
+		t.Error("Dockerfile missing: manifest's default-true Docker variable should have kept it")
+	}
+
+	wantJSON := `{"name": "widget"}` + "\n"
+	if got := rendered["widget.json"]; got != wantJSON {
+		t.Errorf("widget.json = %q, want %q", got, wantJSON)
+	}
+}
+
+// TestRenderTemplateManifestConditionalFileExcluded checks the other
+// side of the Dockerfile rule: a falsy Docker variable drops the file
+// from the render instead of writing an empty or templated one.
+func TestRenderTemplateManifestConditionalFileExcluded(t *testing.T) {
+	projVars = map[string]interface{}{"Docker": false}
+	projName = "widget"
+	projOrg = "acme"
+	projAuthor = "Jane Doe"
+	projDockerTag = "acme/widget"
+
+	tplFS := os.DirFS("testdata/manifest-example")
+
+	manifest, err := templates.LoadManifest(tplFS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := resolveManifestData(manifest, baseTemplateData())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rendered, err := renderTemplate(tplFS, manifest, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := rendered["Dockerfile"]; ok {
+		t.Error("Dockerfile present: projVars' explicit Docker=false should have excluded it")
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -54,6 +54,7 @@ func Execute() error {
 
 func init() {
 	rootCmd.AddCommand(generateCmd)
+	rootCmd.AddCommand(templatesCmd)
 }
 
 /*
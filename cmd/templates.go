@@ -0,0 +1,166 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file templates.go
+ * @package cmd
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/herewetech/hwt/templates"
+	"github.com/spf13/cobra"
+)
+
+var (
+	templatesCmd = &cobra.Command{
+		Use:   "templates",
+		Short: "Manage template sources",
+	}
+
+	templatesListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List registered template sources",
+		Run:   templatesListRun,
+	}
+
+	templatesAddCmd = &cobra.Command{
+		Use:   "add <name> <kind> <ref>",
+		Short: "Register a template source (kind: local, git or http)",
+		Args:  cobra.ExactArgs(3),
+		Run:   templatesAddRun,
+	}
+
+	templatesRemoveCmd = &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a registered template source",
+		Args:  cobra.ExactArgs(1),
+		Run:   templatesRemoveRun,
+	}
+)
+
+func init() {
+	templatesCmd.AddCommand(templatesListCmd, templatesAddCmd, templatesRemoveCmd)
+}
+
+func loadRegistry() (*templates.Registry, string, error) {
+	path, err := templates.ConfigPath()
+	if err != nil {
+		return nil, "", err
+	}
+
+	reg, err := templates.LoadRegistry(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return reg, path, nil
+}
+
+func templatesListRun(cmd *cobra.Command, args []string) {
+	reg, _, err := loadRegistry()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	sources, err := reg.Sources()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	for _, src := range sources {
+		list, err := src.List()
+		if err != nil {
+			fmt.Println(color.HiRedString(err.Error()))
+			os.Exit(1)
+		}
+
+		for _, t := range list {
+			fmt.Println(" => ", color.GreenString(t.Name), " : ", t.Description)
+		}
+	}
+}
+
+func templatesAddRun(cmd *cobra.Command, args []string) {
+	name, kind, ref := args[0], args[1], args[2]
+	switch kind {
+	case "local", "git", "http":
+	default:
+		fmt.Println(color.HiRedString(errors.New("kind must be one of: local, git, http").Error()))
+		os.Exit(1)
+	}
+
+	reg, path, err := loadRegistry()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	reg.Add(templates.RegistryEntry{Name: name, Kind: kind, Ref: ref})
+	if err := reg.Save(); err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	fmt.Println(color.HiGreenString("Added template "+name), " => ", path)
+}
+
+func templatesRemoveRun(cmd *cobra.Command, args []string) {
+	name := args[0]
+	reg, _, err := loadRegistry()
+	if err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	if !reg.Remove(name) {
+		fmt.Println(color.HiRedString(fmt.Sprintf("template %q not found", name)))
+		os.Exit(1)
+	}
+
+	if err := reg.Save(); err != nil {
+		fmt.Println(color.HiRedString(err.Error()))
+		os.Exit(1)
+	}
+
+	fmt.Println(color.HiGreenString("Removed template " + name))
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,87 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file identity.go
+ * @package projectinfo
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+// Package projectinfo auto-detects the user and project identity hwt
+// new would otherwise have to ask for, by reading the local git
+// configuration and any existing project metadata in the working
+// directory.
+package projectinfo
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// GitUserName returns the local "git config user.name", or "" if it
+// isn't set or git isn't available.
+func GitUserName() string {
+	return gitConfig("user.name")
+}
+
+// GitUserEmail returns the local "git config user.email", or "" if it
+// isn't set or git isn't available.
+func GitUserEmail() string {
+	return gitConfig("user.email")
+}
+
+// DetectAuthor combines GitUserName and GitUserEmail into a single
+// "Name <email>" string, suitable as a prompt default. It returns ""
+// if neither is set.
+func DetectAuthor() string {
+	name := GitUserName()
+	email := GitUserEmail()
+
+	switch {
+	case name != "" && email != "":
+		return name + " <" + email + ">"
+	case name != "":
+		return name
+	default:
+		return email
+	}
+}
+
+func gitConfig(key string) string {
+	out, err := exec.Command("git", "config", "--get", key).Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,208 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file remote.go
+ * @package projectinfo
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package projectinfo
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// RemoteInfo is a git remote or module path, split into its host,
+// organization and repository name.
+type RemoteInfo struct {
+	Host string
+	Org  string
+	Repo string
+}
+
+// ParseRemoteURL splits a git remote URL or module path into a
+// RemoteInfo, handling both the scp-like form
+// ("git@github.com:org/name.git") and the URL form
+// ("https://github.com/org/name"). Bare host/org/.../repo paths, as
+// found in a go.mod module line, are also accepted.
+func ParseRemoteURL(raw string) (RemoteInfo, bool) {
+	raw = strings.TrimSpace(raw)
+	raw = strings.TrimSuffix(raw, ".git")
+
+	switch {
+	case strings.HasPrefix(raw, "https://"):
+		return splitHostPath(strings.TrimPrefix(raw, "https://"))
+	case strings.HasPrefix(raw, "http://"):
+		return splitHostPath(strings.TrimPrefix(raw, "http://"))
+	case strings.Contains(raw, "@") && strings.Contains(raw, ":"):
+		hostAndPath := raw[strings.Index(raw, "@")+1:]
+		hostAndPath = strings.Replace(hostAndPath, ":", "/", 1)
+
+		return splitHostPath(hostAndPath)
+	default:
+		return splitHostPath(raw)
+	}
+}
+
+func splitHostPath(hostAndPath string) (RemoteInfo, bool) {
+	parts := strings.Split(hostAndPath, "/")
+	if len(parts) < 3 {
+		return RemoteInfo{}, false
+	}
+
+	host := parts[0]
+	repo := parts[len(parts)-1]
+	org := strings.Join(parts[1:len(parts)-1], "/")
+
+	if host == "" || org == "" || repo == "" {
+		return RemoteInfo{}, false
+	}
+
+	return RemoteInfo{Host: host, Org: org, Repo: repo}, true
+}
+
+// DetectRemote looks for an existing project's remote in dir, trying
+// .git/config, package.json and go.mod in that order, and returns the
+// first one it can parse.
+func DetectRemote(dir string) (RemoteInfo, bool) {
+	if raw, err := os.ReadFile(filepath.Join(dir, ".git", "config")); err == nil {
+		if info, ok := parseGitConfig(raw); ok {
+			return info, true
+		}
+	}
+
+	if raw, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		if info, ok := parsePackageJSON(raw); ok {
+			return info, true
+		}
+	}
+
+	if raw, err := os.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+		if info, ok := parseGoMod(raw); ok {
+			return info, true
+		}
+	}
+
+	return RemoteInfo{}, false
+}
+
+func parseGitConfig(raw []byte) (RemoteInfo, bool) {
+	inOrigin := false
+	for _, line := range strings.Split(string(raw), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			inOrigin = trimmed == `[remote "origin"]`
+
+			continue
+		}
+
+		if !inOrigin || !strings.HasPrefix(trimmed, "url") {
+			continue
+		}
+
+		if _, value, ok := strings.Cut(trimmed, "="); ok {
+			return ParseRemoteURL(value)
+		}
+	}
+
+	return RemoteInfo{}, false
+}
+
+func parseGoMod(raw []byte) (RemoteInfo, bool) {
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if modulePath, ok := strings.CutPrefix(line, "module "); ok {
+			return ParseRemoteURL(stripMajorVersionSuffix(strings.TrimSpace(modulePath)))
+		}
+	}
+
+	return RemoteInfo{}, false
+}
+
+// stripMajorVersionSuffix drops a Go module's "/v2"-style major
+// version suffix, so github.com/org/repo/v2 still resolves to repo
+// "repo" rather than "v2".
+func stripMajorVersionSuffix(modulePath string) string {
+	dir, last := path.Split(modulePath)
+	if len(last) > 1 && last[0] == 'v' {
+		if _, err := strconv.Atoi(last[1:]); err == nil {
+			return strings.TrimSuffix(dir, "/")
+		}
+	}
+
+	return modulePath
+}
+
+func parsePackageJSON(raw []byte) (RemoteInfo, bool) {
+	var pkg struct {
+		Repository json.RawMessage `json:"repository"`
+	}
+
+	if err := json.Unmarshal(raw, &pkg); err != nil || len(pkg.Repository) == 0 {
+		return RemoteInfo{}, false
+	}
+
+	var asString string
+	if err := json.Unmarshal(pkg.Repository, &asString); err == nil {
+		return parseRepositoryField(asString)
+	}
+
+	var asObject struct {
+		URL string `json:"url"`
+	}
+
+	if err := json.Unmarshal(pkg.Repository, &asObject); err == nil {
+		return parseRepositoryField(asObject.URL)
+	}
+
+	return RemoteInfo{}, false
+}
+
+// parseRepositoryField normalizes the handful of shapes npm's
+// package.json#repository field takes ("git+https://...", the
+// "github:org/repo" shorthand) down to something ParseRemoteURL
+// understands.
+func parseRepositoryField(field string) (RemoteInfo, bool) {
+	field = strings.TrimPrefix(field, "git+")
+	if shorthand, ok := strings.CutPrefix(field, "github:"); ok {
+		field = "https://github.com/" + shorthand
+	}
+
+	return ParseRemoteURL(field)
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
@@ -0,0 +1,196 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file remote_test.go
+ * @package projectinfo
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package projectinfo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseRemoteURL(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want RemoteInfo
+		ok   bool
+	}{
+		{
+			raw:  "git@github.com:herewetech/hwt.git",
+			want: RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"},
+			ok:   true,
+		},
+		{
+			raw:  "https://github.com/herewetech/hwt",
+			want: RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"},
+			ok:   true,
+		},
+		{
+			raw:  "https://github.com/herewetech/hwt.git",
+			want: RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"},
+			ok:   true,
+		},
+		{
+			raw:  "github.com/herewetech/hwt",
+			want: RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"},
+			ok:   true,
+		},
+		{
+			raw:  "git@gitlab.internal:team/sub/project.git",
+			want: RemoteInfo{Host: "gitlab.internal", Org: "team/sub", Repo: "project"},
+			ok:   true,
+		},
+		{
+			raw: "not-a-remote",
+			ok:  false,
+		},
+		{
+			raw: "",
+			ok:  false,
+		},
+	}
+
+	for _, c := range cases {
+		got, ok := ParseRemoteURL(c.raw)
+		if ok != c.ok {
+			t.Errorf("ParseRemoteURL(%q) ok = %v, want %v", c.raw, ok, c.ok)
+
+			continue
+		}
+
+		if ok && got != c.want {
+			t.Errorf("ParseRemoteURL(%q) = %+v, want %+v", c.raw, got, c.want)
+		}
+	}
+}
+
+func TestDetectRemoteFromGitConfig(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	config := "[core]\n\trepositoryformatversion = 0\n[remote \"origin\"]\n\turl = git@github.com:herewetech/hwt.git\n\tfetch = +refs/heads/*:refs/remotes/origin/*\n"
+	if err := os.WriteFile(filepath.Join(dir, ".git", "config"), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := DetectRemote(dir)
+	if !ok {
+		t.Fatal("DetectRemote returned ok = false, want true")
+	}
+
+	want := RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"}
+	if got != want {
+		t.Errorf("DetectRemote() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectRemoteFromPackageJSON(t *testing.T) {
+	dir := t.TempDir()
+	pkg := `{"name": "example", "repository": {"type": "git", "url": "git+https://github.com/herewetech/hwt.git"}}`
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(pkg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := DetectRemote(dir)
+	if !ok {
+		t.Fatal("DetectRemote returned ok = false, want true")
+	}
+
+	want := RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"}
+	if got != want {
+		t.Errorf("DetectRemote() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectRemoteFromGoMod(t *testing.T) {
+	dir := t.TempDir()
+	mod := "module github.com/herewetech/hwt\n\ngo 1.21\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := DetectRemote(dir)
+	if !ok {
+		t.Fatal("DetectRemote returned ok = false, want true")
+	}
+
+	want := RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"}
+	if got != want {
+		t.Errorf("DetectRemote() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectRemoteFromGoModMajorVersion(t *testing.T) {
+	dir := t.TempDir()
+	mod := "module github.com/herewetech/hwt/v2\n\ngo 1.21\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := DetectRemote(dir)
+	if !ok {
+		t.Fatal("DetectRemote returned ok = false, want true")
+	}
+
+	want := RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"}
+	if got != want {
+		t.Errorf("DetectRemote() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectRemoteNone(t *testing.T) {
+	dir := t.TempDir()
+	if _, ok := DetectRemote(dir); ok {
+		t.Error("DetectRemote() ok = true for an empty directory, want false")
+	}
+}
+
+func TestParseRepositoryFieldShorthand(t *testing.T) {
+	got, ok := parseRepositoryField("github:herewetech/hwt")
+	if !ok {
+		t.Fatal("parseRepositoryField returned ok = false, want true")
+	}
+
+	want := RemoteInfo{Host: "github.com", Org: "herewetech", Repo: "hwt"}
+	if got != want {
+		t.Errorf("parseRepositoryField() = %+v, want %+v", got, want)
+	}
+}
+
+/*
+ * Local variables:
+ * tab-width: 4
+ * c-basic-offset: 4
+ * End:
+ * vim600: sw=4 ts=4 fdm=marker
+ * vim<600: sw=4 ts=4
+ */
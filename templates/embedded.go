@@ -0,0 +1,114 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file embedded.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/bzip2"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"testing/fstest"
+)
+
+//go:embed assets/default.tar.bz2
+var defaultArchive embed.FS
+
+// EmbeddedSource serves the starter(s) baked into the hwt binary itself.
+// It is always available, even offline, and is the default when no
+// --template flag is given.
+type EmbeddedSource struct{}
+
+// NewEmbeddedSource returns a Source backed by the binary's embedded assets.
+func NewEmbeddedSource() *EmbeddedSource {
+	return &EmbeddedSource{}
+}
+
+func (s *EmbeddedSource) Name() string {
+	return "embedded"
+}
+
+func (s *EmbeddedSource) List() ([]Template, error) {
+	return []Template{
+		{
+			Name:        "default",
+			Description: "Bare HereweTech service skeleton",
+			Ref:         "default",
+		},
+	}, nil
+}
+
+func (s *EmbeddedSource) Fetch(ref string) (fs.FS, error) {
+	if ref != "" && ref != "default" {
+		return nil, fmt.Errorf("embedded: unknown template %q", ref)
+	}
+
+	raw, err := defaultArchive.ReadFile("assets/default.tar.bz2")
+	if err != nil {
+		return nil, err
+	}
+
+	return untarToMapFS(bzip2.NewReader(bytes.NewReader(raw)))
+}
+
+// untarToMapFS reads a tar stream fully into memory and exposes it as an fs.FS.
+func untarToMapFS(r io.Reader) (fs.FS, error) {
+	mfs := fstest.MapFS{}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if hdr.FileInfo().IsDir() {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		mfs[hdr.Name] = &fstest.MapFile{
+			Data: data,
+			Mode: hdr.FileInfo().Mode(),
+		}
+	}
+
+	return mfs, nil
+}
@@ -0,0 +1,160 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file git.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing/fstest"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// GitSource clones a remote repository into a temporary worktree and
+// serves it as a template. The ref is the clone URL itself; branch or
+// tag selection can be appended after a '#' (e.g. "url#v1.2.0").
+type GitSource struct {
+	name string
+	url  string
+}
+
+// NewGitSource returns a Source backed by a single git remote.
+func NewGitSource(name, url string) *GitSource {
+	return &GitSource{name: name, url: url}
+}
+
+func (s *GitSource) Name() string {
+	return "git"
+}
+
+func (s *GitSource) List() ([]Template, error) {
+	return []Template{
+		{
+			Name:        s.name,
+			Description: "Git repository " + s.url,
+			Ref:         s.url,
+		},
+	}, nil
+}
+
+func (s *GitSource) Fetch(ref string) (fs.FS, error) {
+	url := ref
+	if url == "" {
+		url = s.url
+	}
+
+	url, rev, _ := strings.Cut(url, "#")
+
+	dir, err := os.MkdirTemp("", "hwt-template-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	opts := &git.CloneOptions{
+		URL:   url,
+		Depth: 1,
+	}
+	if rev != "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(rev)
+	}
+
+	_, err = git.PlainClone(dir, false, opts)
+	if rev != "" && err != nil {
+		// rev might name a tag rather than a branch; retry before
+		// giving up.
+		opts.ReferenceName = plumbing.NewTagReferenceName(rev)
+		_, err = git.PlainClone(dir, false, opts)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("git: clone %s: %w", url, err)
+	}
+
+	tplFS, err := dirToMapFS(dir)
+	if err != nil {
+		return nil, fmt.Errorf("git: read clone of %s: %w", url, err)
+	}
+
+	return tplFS, nil
+}
+
+// dirToMapFS reads a directory tree fully into memory, so the caller
+// can use it as an fs.FS after the on-disk clone it came from has been
+// removed.
+func dirToMapFS(dir string) (fs.FS, error) {
+	mfs := fstest.MapFS{}
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		mfs[filepath.ToSlash(rel)] = &fstest.MapFile{
+			Data: data,
+			Mode: info.Mode(),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mfs, nil
+}
@@ -0,0 +1,160 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file http.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+// HTTPSource fetches a template as a single tar(.gz/.bz2) or zip archive
+// served over HTTPS, e.g. a GitHub "archive/refs/heads/main.zip" link.
+type HTTPSource struct {
+	name string
+	url  string
+}
+
+// NewHTTPSource returns a Source backed by a single downloadable archive.
+func NewHTTPSource(name, url string) *HTTPSource {
+	return &HTTPSource{name: name, url: url}
+}
+
+func (s *HTTPSource) Name() string {
+	return "http"
+}
+
+func (s *HTTPSource) List() ([]Template, error) {
+	return []Template{
+		{
+			Name:        s.name,
+			Description: "HTTPS archive " + s.url,
+			Ref:         s.url,
+		},
+	}, nil
+}
+
+func (s *HTTPSource) Fetch(ref string) (fs.FS, error) {
+	url := ref
+	if url == "" {
+		url = s.url
+	}
+
+	if !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("http: refusing non-HTTPS url %q", url)
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http: fetching %s: status %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case strings.HasSuffix(url, ".zip"):
+		return unzipToMapFS(body)
+	case strings.HasSuffix(url, ".tar.gz"), strings.HasSuffix(url, ".tgz"):
+		gr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		return untarToMapFS(gr)
+	case strings.HasSuffix(url, ".tar.bz2"):
+		return untarToMapFS(bzip2.NewReader(bytes.NewReader(body)))
+	case strings.HasSuffix(url, ".tar"):
+		return untarToMapFS(bytes.NewReader(body))
+	default:
+		return nil, fmt.Errorf("http: unrecognized archive extension for %s", url)
+	}
+}
+
+func unzipToMapFS(body []byte) (fs.FS, error) {
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		hdr := &tar.Header{
+			Name: f.Name,
+			Mode: int64(f.Mode()),
+			Size: int64(len(data)),
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+
+		if _, err := tw.Write(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return untarToMapFS(&buf)
+}
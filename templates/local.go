@@ -0,0 +1,92 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file local.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalSource serves a single template rooted at a directory on disk,
+// handy for template authors iterating before they push to git.
+type LocalSource struct {
+	name string
+	dir  string
+}
+
+// NewLocalSource returns a Source that treats dir as a single template
+// named name.
+func NewLocalSource(name, dir string) *LocalSource {
+	return &LocalSource{name: name, dir: dir}
+}
+
+func (s *LocalSource) Name() string {
+	return "local"
+}
+
+func (s *LocalSource) List() ([]Template, error) {
+	if _, err := os.Stat(s.dir); err != nil {
+		return nil, err
+	}
+
+	return []Template{
+		{
+			Name:        s.name,
+			Description: "Local directory " + s.dir,
+			Ref:         s.dir,
+		},
+	}, nil
+}
+
+func (s *LocalSource) Fetch(ref string) (fs.FS, error) {
+	dir := ref
+	if dir == "" {
+		dir = s.dir
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(abs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fi.IsDir() {
+		return nil, fmt.Errorf("local: %s is not a directory", abs)
+	}
+
+	return os.DirFS(abs), nil
+}
@@ -0,0 +1,103 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file manifest.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile is the name of the optional descriptor at a template's root.
+const ManifestFile = "manifest.yaml"
+
+// Variable describes one value a template needs from its caller, along
+// with how to prompt for it and how to validate what comes back.
+type Variable struct {
+	Name     string      `yaml:"name"`
+	Prompt   string      `yaml:"prompt"`
+	Default  interface{} `yaml:"default"`
+	Validate string      `yaml:"validate"` // regular expression, empty means "anything goes"
+}
+
+// FileRule conditionally includes an output path. If is a Go template
+// expression evaluated against the render data; the file is emitted only
+// when it renders to the literal string "true".
+type FileRule struct {
+	Path string `yaml:"path"`
+	If   string `yaml:"if"`
+}
+
+// Manifest is the optional manifest.yaml a template ships at its root to
+// declare variables and conditional files beyond the fixed set hwt knows
+// about natively (ProjName, ProjOrg, ProjAuthor, Today).
+type Manifest struct {
+	Variables []Variable `yaml:"variables"`
+	Files     []FileRule `yaml:"files"`
+}
+
+// LoadManifest reads manifest.yaml from the root of tplFS. A template
+// without one is valid and simply has no variables or conditional files;
+// LoadManifest then returns (nil, nil).
+func LoadManifest(tplFS fs.FS) (*Manifest, error) {
+	raw, err := fs.ReadFile(tplFS, ManifestFile)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// RuleFor returns the FileRule declared for path, if any.
+func (m *Manifest) RuleFor(path string) (FileRule, bool) {
+	if m == nil {
+		return FileRule{}, false
+	}
+
+	for _, r := range m.Files {
+		if r.Path == path {
+			return r, true
+		}
+	}
+
+	return FileRule{}, false
+}
@@ -0,0 +1,153 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file registry.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RegistryEntry is one user-added template source, persisted in
+// ~/.hwt/config.yaml.
+type RegistryEntry struct {
+	Name string `yaml:"name"`
+	Kind string `yaml:"kind"` // "local", "git" or "http"
+	Ref  string `yaml:"ref"`
+}
+
+// Registry is the on-disk list of template sources a user has added on
+// top of the built-in embedded one.
+type Registry struct {
+	Templates []RegistryEntry `yaml:"templates"`
+
+	path string
+}
+
+// ConfigPath returns the default location of the registry file,
+// ~/.hwt/config.yaml.
+func ConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".hwt", "config.yaml"), nil
+}
+
+// LoadRegistry reads the registry from path, returning an empty one if
+// the file does not exist yet.
+func LoadRegistry(path string) (*Registry, error) {
+	reg := &Registry{path: path}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return reg, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(raw, reg); err != nil {
+		return nil, err
+	}
+
+	reg.path = path
+
+	return reg, nil
+}
+
+// Save writes the registry back to disk, creating parent directories
+// as needed.
+func (r *Registry) Save() error {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, raw, 0644)
+}
+
+// Add registers a new template source, replacing any existing entry
+// with the same name.
+func (r *Registry) Add(entry RegistryEntry) {
+	for i, e := range r.Templates {
+		if e.Name == entry.Name {
+			r.Templates[i] = entry
+
+			return
+		}
+	}
+
+	r.Templates = append(r.Templates, entry)
+}
+
+// Remove drops the entry with the given name, reporting whether it was
+// found.
+func (r *Registry) Remove(name string) bool {
+	for i, e := range r.Templates {
+		if e.Name == name {
+			r.Templates = append(r.Templates[:i], r.Templates[i+1:]...)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sources builds the live Source implementations for every registry
+// entry, in addition to the always-present embedded one.
+func (r *Registry) Sources() ([]Source, error) {
+	sources := []Source{NewEmbeddedSource()}
+	for _, e := range r.Templates {
+		switch e.Kind {
+		case "local":
+			sources = append(sources, NewLocalSource(e.Name, e.Ref))
+		case "git":
+			sources = append(sources, NewGitSource(e.Name, e.Ref))
+		case "http":
+			sources = append(sources, NewHTTPSource(e.Name, e.Ref))
+		default:
+			return nil, fmt.Errorf("registry: unknown template kind %q for %q", e.Kind, e.Name)
+		}
+	}
+
+	return sources, nil
+}
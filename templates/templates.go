@@ -0,0 +1,55 @@
+/*
+ * The MIT License (MIT)
+ *
+ * Copyright (c) 2021 HereweTech Co.LTD
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of
+ * this software and associated documentation files (the "Software"), to deal in
+ * the Software without restriction, including without limitation the rights to
+ * use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+ * the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+ * FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+ * COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+ * IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+ * CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+/**
+ * @file templates.go
+ * @package templates
+ * @author Dr.NP <np@herewe.tech>
+ * @since 07/26/2026
+ */
+
+// Package templates provides pluggable sources for project starters.
+// A Source lists the templates it knows about and fetches one of them
+// as an fs.FS so the caller can unpack it without caring whether it
+// came from the embedded default, a local directory, a git remote or
+// a plain HTTP archive.
+package templates
+
+import "io/fs"
+
+// Template describes a single starter offered by a Source.
+type Template struct {
+	Name        string
+	Description string
+	Ref         string
+}
+
+// Source is implemented by anything that can list and fetch templates.
+type Source interface {
+	// Name identifies the source, e.g. "embedded", "local", "git", "http".
+	Name() string
+	// List returns the templates available from this source.
+	List() ([]Template, error)
+	// Fetch returns the filesystem tree for the template referenced by ref.
+	Fetch(ref string) (fs.FS, error)
+}